@@ -0,0 +1,56 @@
+package workerpool_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sammyne/workerpool"
+)
+
+type failFastAction struct {
+	err error
+}
+
+func (a failFastAction) Execute(context.Context) error {
+	return a.err
+}
+
+// sleepIgnoringCtxAction sleeps for d regardless of ctx, so the only way
+// Execute can observe it finishing is to actually wait for it.
+type sleepIgnoringCtxAction struct {
+	d       time.Duration
+	stopped *int32
+}
+
+func (a sleepIgnoringCtxAction) Execute(context.Context) error {
+	time.Sleep(a.d)
+	atomic.StoreInt32(a.stopped, 1)
+	return nil
+}
+
+func TestPool_Execute_BlocksUntilSiblingActionFinishes(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	p := workerpool.Pool(workerpool.PoolConfig{Workers: 2}, done)
+
+	boom := errors.New("boom")
+	var stopped int32
+
+	actions := []workerpool.Action{
+		failFastAction{err: boom},
+		sleepIgnoringCtxAction{d: 300 * time.Millisecond, stopped: &stopped},
+	}
+
+	err := p.Execute(context.Background(), actions)
+	if !errors.Is(err, boom) {
+		t.Fatalf("got error %v, want %v", err, boom)
+	}
+
+	if atomic.LoadInt32(&stopped) != 1 {
+		t.Fatal("Execute returned before its sibling action finished running")
+	}
+}
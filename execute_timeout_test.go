@@ -0,0 +1,43 @@
+package workerpool_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sammyne/workerpool"
+)
+
+type sleepAction struct {
+	d time.Duration
+}
+
+func (a sleepAction) Execute(ctx context.Context) error {
+	select {
+	case <-time.After(a.d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestExecuteWithTimeout_BoundsWallClock(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	p := workerpool.Pool(workerpool.PoolConfig{Workers: 1}, done)
+
+	const timeout = 50 * time.Millisecond
+
+	start := time.Now()
+	err := p.ExecuteWithTimeout(context.Background(), timeout, []workerpool.Action{sleepAction{d: 2 * time.Second}})
+	elapsed := time.Since(start)
+
+	if err != workerpool.ErrTimeout {
+		t.Fatalf("got error %v, want %v", err, workerpool.ErrTimeout)
+	}
+
+	if elapsed > time.Second {
+		t.Fatalf("ExecuteWithTimeout took %s, want it to return close to the %s timeout", elapsed, timeout)
+	}
+}
@@ -0,0 +1,61 @@
+package workerpool_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sammyne/workerpool"
+)
+
+type panicAction struct{}
+
+func (panicAction) Execute(context.Context) error {
+	panic("boom")
+}
+
+type noopAction struct{}
+
+func (noopAction) Execute(context.Context) error {
+	return nil
+}
+
+func TestPool_RecoversPanicAndKeepsWorkerAlive(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	var handled interface{}
+	opt := workerpool.WithPanicHandler(func(_ context.Context, r interface{}) {
+		handled = r
+	})
+
+	p := workerpool.Pool(workerpool.PoolConfig{Workers: 1}, done, opt)
+
+	if err := p.Execute(context.Background(), []workerpool.Action{panicAction{}}); err == nil {
+		t.Fatal("got nil error, want the recovered panic reported as an error")
+	}
+
+	if handled != "boom" {
+		t.Fatalf("got handled=%v, want %q", handled, "boom")
+	}
+
+	// The worker goroutine must still be serving the pool after the panic.
+	if err := p.Execute(context.Background(), []workerpool.Action{noopAction{}}); err != nil {
+		t.Fatalf("got error %v after recovery, want nil", err)
+	}
+}
+
+func TestWithPanicHandler_NilFallsBackToDefault(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	p := workerpool.Pool(workerpool.PoolConfig{Workers: 1}, done, workerpool.WithPanicHandler(nil))
+
+	if err := p.Execute(context.Background(), []workerpool.Action{panicAction{}}); err == nil {
+		t.Fatal("got nil error, want the recovered panic reported as an error")
+	}
+
+	// Must not crash the process by invoking a nil handler.
+	if err := p.Execute(context.Background(), []workerpool.Action{noopAction{}}); err != nil {
+		t.Fatalf("got error %v after recovery, want nil", err)
+	}
+}
@@ -3,7 +3,13 @@ package workerpool
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 type poolAction struct {
@@ -13,8 +19,47 @@ type poolAction struct {
 }
 
 type pool struct {
-	done <-chan struct{}
-	in   chan poolAction
+	done         <-chan struct{}
+	in           chan poolAction
+	panicHandler func(ctx context.Context, r interface{})
+	workers      *poolWorkers
+	stats        *poolStats
+}
+
+// poolStats holds the atomic counters backing Stats.
+type poolStats struct {
+	inFlight  int64
+	completed uint64
+	failed    uint64
+}
+
+// poolWorkers tracks the quit channels of the currently running workers, one
+// per worker, so Resize can grow or shrink the pool concurrently with
+// Execute/Submit.
+type poolWorkers struct {
+	mu   sync.Mutex
+	quit []chan struct{}
+}
+
+// PoolOption customises an Executor created by Pool.
+type PoolOption func(*pool)
+
+// WithPanicHandler makes the Executor call h whenever an Action panics,
+// instead of the default behaviour of logging the recovered value and stack
+// trace. The panicking Action is reported as an error; the worker goroutine
+// that ran it stays alive and keeps serving the pool. A nil h falls back to
+// the default handler rather than being installed as-is.
+func WithPanicHandler(h func(ctx context.Context, r interface{})) PoolOption {
+	return func(p *pool) {
+		if h == nil {
+			h = defaultPanicHandler
+		}
+		p.panicHandler = h
+	}
+}
+
+func defaultPanicHandler(_ context.Context, r interface{}) {
+	log.Printf("workerpool: recovered panic in action: %v\n%s", r, debug.Stack())
 }
 
 // Execute enqueues all Actions on the worker pool, failing closed on the
@@ -27,6 +72,13 @@ func (p pool) Execute(ctx context.Context, actions []Action) error {
 		return nil
 	}
 
+	// Captured before wrapping ctx below, so it only fires on genuine
+	// external cancellation (the caller's cancel/deadline) - not on the
+	// cancel() this method calls on itself once a sibling action errors,
+	// which must not cut the drain loop short for the rest of the batch.
+	externalDone := ctx.Done()
+	parent := ctx
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -42,7 +94,7 @@ enqueue:
 		case <-p.done: // pool is closed
 			cancel()
 			return errors.New("pool is closed")
-		case <-ctx.Done(): // ctx is closed by caller
+		case <-externalDone: // ctx is closed by caller
 			err = ctx.Err()
 			break enqueue
 		case p.in <- pa: // enqueue action
@@ -50,6 +102,113 @@ enqueue:
 		}
 	}
 
+	for remaining := queued; remaining > 0; {
+		select {
+		case <-externalDone: // ctx is closed by caller, including by a timeout
+			if err == nil {
+				// parent's error is set before its Done closes; the derived
+				// ctx may not report its error yet at this point.
+				err = parent.Err()
+			}
+			cancel()
+
+			// The remaining responses are still coming; drain them before
+			// returning so every queued action has sent its response.
+			drainResponses(res, remaining)
+
+			return err
+		case r := <-res:
+			remaining--
+			if r != nil && err == nil {
+				err = r
+				cancel()
+			}
+		}
+	}
+
+	return err
+}
+
+func drainResponses(res <-chan error, n uint64) {
+	for ; n > 0; n-- {
+		<-res
+	}
+}
+
+// ErrTimeout is returned by ExecuteWithTimeout and ExecuteWithDeadline when
+// actions does not finish executing before the deadline.
+var ErrTimeout = errors.New("workerpool: timed out")
+
+// ExecuteWithTimeout behaves like Execute, but fails with ErrTimeout if
+// actions does not finish within d. The res channel is still drained for
+// every queued action before returning, so a timeout never leaves a worker
+// goroutine blocked writing to a stale response channel.
+func (p pool) ExecuteWithTimeout(parent context.Context, d time.Duration, actions []Action) error {
+	ctx, cancel := context.WithTimeout(parent, d)
+	defer cancel()
+
+	return p.executeDeadline(ctx, actions)
+}
+
+// ExecuteWithDeadline behaves like ExecuteWithTimeout, but fails with
+// ErrTimeout if actions does not finish by deadline.
+func (p pool) ExecuteWithDeadline(parent context.Context, deadline time.Time, actions []Action) error {
+	ctx, cancel := context.WithDeadline(parent, deadline)
+	defer cancel()
+
+	return p.executeDeadline(ctx, actions)
+}
+
+func (p pool) executeDeadline(ctx context.Context, actions []Action) error {
+	if err := p.Execute(ctx, actions); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return ErrTimeout
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ErrQueueFull is returned by TryExecute when the pool's queue has no room
+// for all of the submitted Actions.
+var ErrQueueFull = errors.New("workerpool: queue is full")
+
+// TryExecute behaves like Execute, but returns ErrQueueFull immediately
+// instead of blocking if the queue does not have room for all of actions.
+// This gives callers a backpressure signal to make their own load-shedding
+// decisions rather than stalling on a saturated pool.
+func (p pool) TryExecute(ctx context.Context, actions []Action) error {
+	qty := len(actions)
+	if qty == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	res := make(chan error, qty)
+
+	var queued uint64
+
+	for _, action := range actions {
+		pa := poolAction{ctx: ctx, action: action, response: res}
+		select {
+		case <-p.done: // pool is closed
+			cancel()
+			return errors.New("pool is closed")
+		case <-ctx.Done(): // ctx is closed by caller
+			cancel()
+			return ctx.Err()
+		case p.in <- pa: // enqueue action
+			queued++
+		default: // queue is saturated
+			cancel()
+			return ErrQueueFull
+		}
+	}
+
+	var err error
 	for ; queued > 0; queued-- {
 		if r := <-res; r != nil {
 			if err == nil {
@@ -62,31 +221,158 @@ enqueue:
 	return err
 }
 
-func (p pool) work(in <-chan poolAction, done <-chan struct{}) {
+// Stats reports point-in-time observability counters for the pool.
+func (p pool) Stats() Stats {
+	return Stats{
+		Workers:   p.Size(),
+		Queued:    len(p.in),
+		InFlight:  int(atomic.LoadInt64(&p.stats.inFlight)),
+		Completed: atomic.LoadUint64(&p.stats.completed),
+		Failed:    atomic.LoadUint64(&p.stats.failed),
+	}
+}
+
+// Submit enqueues action without blocking for a free worker, returning a
+// Future that resolves to the action's error once a worker runs it. Unlike
+// Execute, Submit does not require the caller to pre-collect a batch: each
+// call fans in independently, which suits callers driven by many goroutines
+// (HTTP handlers, stream consumers) rather than a single producer.
+func (p pool) Submit(ctx context.Context, action Action) Future {
+	ctx, cancel := context.WithCancel(ctx)
+	res := make(chan error, 1)
+
+	go func() {
+		pa := poolAction{ctx: ctx, action: action, response: res}
+		select {
+		case <-p.done: // pool is closed
+			res <- errors.New("pool is closed")
+		case <-ctx.Done(): // ctx is closed by caller
+			res <- ctx.Err()
+		case p.in <- pa: // enqueue action
+		}
+	}()
+
+	return &future{done: res, cancel: cancel}
+}
+
+type future struct {
+	done   chan error
+	cancel context.CancelFunc
+}
+
+func (f *future) Wait() error {
+	return <-f.done
+}
+
+func (f *future) Done() <-chan error {
+	return f.done
+}
+
+func (f *future) Cancel() {
+	f.cancel()
+}
+
+func (p pool) work(in <-chan poolAction, done, quit <-chan struct{}) {
 	for {
 		select {
 		case <-done:
 			return
+		case <-quit:
+			return
 		case a := <-in:
-			a.response <- a.action.Execute(a.ctx)
+			a.response <- p.run(a)
+		}
+	}
+}
+
+// Size returns the number of worker goroutines currently serving the pool.
+func (p pool) Size() int {
+	p.workers.mu.Lock()
+	defer p.workers.mu.Unlock()
+
+	return len(p.workers.quit)
+}
+
+// Resize grows or shrinks the pool to n worker goroutines, without
+// recreating it or disrupting in-flight Execute/Submit calls. Growth spawns
+// additional workers against the existing queue; shrinkage signals the
+// excess workers to quit once they finish their current Action, if any. It
+// is safe to call concurrently with Execute, Submit and itself.
+func (p pool) Resize(n int) error {
+	if n <= 0 {
+		return errors.New("workerpool: size must be positive")
+	}
+
+	p.workers.mu.Lock()
+	defer p.workers.mu.Unlock()
+
+	switch cur := len(p.workers.quit); {
+	case n > cur:
+		for i := cur; i < n; i++ {
+			quit := make(chan struct{})
+			p.workers.quit = append(p.workers.quit, quit)
+			go p.work(p.in, p.done, quit)
 		}
+	case n < cur:
+		for i := cur - 1; i >= n; i-- {
+			close(p.workers.quit[i])
+		}
+		p.workers.quit = p.workers.quit[:n]
 	}
+
+	return nil
 }
 
-// Pool creates an Executor backed by a concurrent worker pool. Up to n Actions
-// can be in-flight simultaneously; if n is less than or equal to zero,
-// runtime.NumCPU is used. The done channel should be closed to release
+// run executes a.action, recovering from any panic so a single bad Action
+// cannot kill the worker goroutine and leak a pool slot, and updates the
+// in-flight/completed/failed counters backing Stats.
+func (p pool) run(a poolAction) (err error) {
+	atomic.AddInt64(&p.stats.inFlight, 1)
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("workerpool: action panicked: %v", r)
+			p.panicHandler(a.ctx, r)
+		}
+
+		atomic.AddInt64(&p.stats.inFlight, -1)
+		if err != nil {
+			atomic.AddUint64(&p.stats.failed, 1)
+		} else {
+			atomic.AddUint64(&p.stats.completed, 1)
+		}
+	}()
+
+	return a.action.Execute(a.ctx)
+}
+
+// Pool creates an Executor backed by a concurrent worker pool, sized and
+// queued according to cfg. The done channel should be closed to release
 // resources held by the Executor.
-func Pool(n int, done <-chan struct{}) Executor {
+func Pool(cfg PoolConfig, done <-chan struct{}, opts ...PoolOption) Executor {
+	n := cfg.Workers
 	if n <= 0 {
 		n = runtime.NumCPU()
 	}
 
-	p := pool{done: done, in: make(chan poolAction, n)}
+	queueSize := cfg.QueueSize
+	if queueSize <= 0 {
+		queueSize = n * 10
+	}
 
-	for i := 0; i < n; i++ {
-		go p.work(p.in, p.done)
+	p := pool{
+		done:         done,
+		in:           make(chan poolAction, queueSize),
+		panicHandler: defaultPanicHandler,
+		workers:      &poolWorkers{},
+		stats:        &poolStats{},
 	}
 
+	for _, opt := range opts {
+		opt(&p)
+	}
+
+	_ = p.Resize(n)
+
 	return p
 }
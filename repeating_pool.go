@@ -0,0 +1,88 @@
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Done is returned by Worker.Run to signal that the worker has finished its
+// repeating work and should stop, without that being treated as a failure of
+// the RepeatingPool.
+var Done = errors.New("workerpool: worker done")
+
+// Worker is a long-lived, stateful unit of work run repeatedly by a
+// RepeatingPool until it returns Done or a non-nil error.
+type Worker interface {
+	// Run performs one iteration of the worker's loop. Returning Done stops
+	// the worker cleanly; any other non-nil error cancels the whole pool.
+	Run(ctx context.Context) error
+
+	// Close releases any resources held by the worker. It is called exactly
+	// once, regardless of how the worker stopped.
+	Close() error
+}
+
+// RepeatingPool runs a heterogeneous set of Workers side by side, restarting
+// each one's Run method until it signals Done, while any Worker's error
+// cancels the rest. It complements the stateless, batch-oriented Executor
+// with a primitive suited to long-lived workloads.
+type RepeatingPool struct {
+	workers []Worker
+}
+
+// NewRepeatingPool creates a RepeatingPool over workers.
+func NewRepeatingPool(workers []Worker) *RepeatingPool {
+	return &RepeatingPool{workers: workers}
+}
+
+// Run starts every worker at the same instant - each one waits at a starting
+// gun until the last worker is ready - and blocks until they have all
+// stopped or one returns an error, which cancels ctx for the rest. Close is
+// guaranteed to be called on every worker exactly once; any error it returns
+// is joined into that worker's error. Run returns the first error returned
+// by any worker, so errors from the other workers are not reported.
+func (p *RepeatingPool) Run(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	var ready sync.WaitGroup
+	ready.Add(len(p.workers))
+
+	gun := make(chan struct{})
+
+	for _, w := range p.workers {
+		w := w
+		g.Go(func() (err error) {
+			defer func() {
+				if cerr := w.Close(); cerr != nil {
+					err = errors.Join(err, cerr)
+				}
+			}()
+
+			ready.Done()
+			<-gun // wait for every worker to be ready before starting
+
+			for {
+				switch rerr := w.Run(ctx); {
+				case errors.Is(rerr, Done):
+					return nil
+				case rerr != nil:
+					return rerr
+				}
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+				}
+			}
+		})
+	}
+
+	ready.Wait()
+	close(gun)
+
+	return g.Wait()
+}
@@ -0,0 +1,53 @@
+package workerpool_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sammyne/workerpool"
+)
+
+type stubWorker struct {
+	runs     int
+	failOn   int
+	runErr   error
+	closeErr error
+}
+
+func (w *stubWorker) Run(ctx context.Context) error {
+	w.runs++
+	if w.runs == w.failOn {
+		return w.runErr
+	}
+	return workerpool.Done
+}
+
+func (w *stubWorker) Close() error {
+	return w.closeErr
+}
+
+func TestRepeatingPool_ReturnsWorkerError(t *testing.T) {
+	boom := errors.New("boom")
+
+	failing := &stubWorker{failOn: 1, runErr: boom}
+	other := &stubWorker{failOn: -1} // never fails; stops via Done instead
+
+	p := workerpool.NewRepeatingPool([]workerpool.Worker{failing, other})
+
+	if err := p.Run(context.Background()); !errors.Is(err, boom) {
+		t.Fatalf("got error %v, want it to wrap %v", err, boom)
+	}
+}
+
+func TestRepeatingPool_JoinsCloseError(t *testing.T) {
+	closeErr := errors.New("close failed")
+
+	w := &stubWorker{failOn: -1, closeErr: closeErr}
+
+	p := workerpool.NewRepeatingPool([]workerpool.Worker{w})
+
+	if err := p.Run(context.Background()); !errors.Is(err, closeErr) {
+		t.Fatalf("got error %v, want it to wrap %v", err, closeErr)
+	}
+}
@@ -0,0 +1,55 @@
+package workerpool_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sammyne/workerpool"
+)
+
+func TestPool_ResizeConcurrentWithExecute(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	p := workerpool.Pool(workerpool.PoolConfig{Workers: 2}, done)
+
+	var wg sync.WaitGroup
+
+	// Keep the pool busy with Actions while Resize grows and shrinks it.
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			actions := []workerpool.Action{
+				sleepAction{d: time.Millisecond},
+				sleepAction{d: time.Millisecond},
+			}
+			if err := p.Execute(context.Background(), actions); err != nil {
+				t.Errorf("Execute: got error %v, want nil", err)
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			n := 1 + i%8
+			if err := p.Resize(n); err != nil {
+				t.Errorf("Resize(%d): got error %v, want nil", n, err)
+			}
+		}
+	}()
+
+	wg.Wait()
+
+	if err := p.Resize(4); err != nil {
+		t.Fatalf("Resize: got error %v, want nil", err)
+	}
+
+	if got := p.Size(); got != 4 {
+		t.Fatalf("Size() = %d, want 4", got)
+	}
+}
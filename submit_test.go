@@ -0,0 +1,51 @@
+package workerpool_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sammyne/workerpool"
+)
+
+type errAction struct {
+	err error
+}
+
+func (a errAction) Execute(context.Context) error {
+	return a.err
+}
+
+func TestPool_SubmitFuture_Wait(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	p := workerpool.Pool(workerpool.PoolConfig{Workers: 1}, done)
+
+	boom := errors.New("boom")
+	f := p.Submit(context.Background(), errAction{err: boom})
+
+	if err := f.Wait(); !errors.Is(err, boom) {
+		t.Fatalf("got error %v, want %v", err, boom)
+	}
+}
+
+func TestPool_SubmitFuture_CancelIsIndependent(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	p := workerpool.Pool(workerpool.PoolConfig{Workers: 1}, done)
+
+	blocker := p.Submit(context.Background(), sleepAction{d: 200 * time.Millisecond})
+	cancelled := p.Submit(context.Background(), sleepAction{d: time.Second})
+	cancelled.Cancel()
+
+	if err := <-cancelled.Done(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+
+	if err := blocker.Wait(); err != nil {
+		t.Fatalf("cancelling one Future affected another in-flight one: got error %v, want nil", err)
+	}
+}
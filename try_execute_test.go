@@ -0,0 +1,37 @@
+package workerpool_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sammyne/workerpool"
+)
+
+func TestPool_TryExecute_ErrQueueFullWhenSaturated(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	p := workerpool.Pool(workerpool.PoolConfig{Workers: 1, QueueSize: 1}, done)
+
+	// Occupy the single worker and fill the one-slot queue behind it.
+	blocker := p.Submit(context.Background(), sleepAction{d: 200 * time.Millisecond})
+	filler := p.Submit(context.Background(), sleepAction{d: 200 * time.Millisecond})
+
+	// Give both Submit goroutines a chance to reach the worker/queue before
+	// asserting the queue is saturated.
+	time.Sleep(50 * time.Millisecond)
+
+	err := p.TryExecute(context.Background(), []workerpool.Action{noopAction{}})
+	if !errors.Is(err, workerpool.ErrQueueFull) {
+		t.Fatalf("got error %v, want %v", err, workerpool.ErrQueueFull)
+	}
+
+	if err := blocker.Wait(); err != nil {
+		t.Fatalf("blocker.Wait(): got error %v, want nil", err)
+	}
+	if err := filler.Wait(); err != nil {
+		t.Fatalf("filler.Wait(): got error %v, want nil", err)
+	}
+}
@@ -0,0 +1,99 @@
+// Package workerpool provides a small, concurrent worker pool for running
+// batches of Actions with bounded parallelism.
+package workerpool
+
+import (
+	"context"
+	"time"
+)
+
+// Action is a unit of work that can be run on an Executor.
+type Action interface {
+	Execute(ctx context.Context) error
+}
+
+// Executor runs batches of Actions on a pool of workers.
+type Executor interface {
+	// Execute enqueues all Actions on the worker pool, failing closed on the
+	// first error or if ctx is cancelled. This method blocks until all
+	// enqueued Actions have returned. In the event of an error, not all
+	// Actions may be executed.
+	Execute(ctx context.Context, actions []Action) error
+
+	// Submit enqueues action without waiting for a worker to run it, handing
+	// back a Future the caller can use to observe its result. Submitted
+	// Actions are independent of one another: cancelling or waiting on one
+	// Future has no effect on any other in-flight work.
+	Submit(ctx context.Context, action Action) Future
+
+	// Size returns the number of worker goroutines currently serving the
+	// pool.
+	Size() int
+
+	// Resize grows or shrinks the pool to n worker goroutines at runtime,
+	// without recreating it. It is safe to call concurrently with Execute
+	// and Submit.
+	Resize(n int) error
+
+	// TryExecute behaves like Execute, but returns ErrQueueFull immediately
+	// instead of blocking if the queue does not have room for all of
+	// actions.
+	TryExecute(ctx context.Context, actions []Action) error
+
+	// Stats reports point-in-time observability counters for the pool.
+	Stats() Stats
+
+	// ExecuteWithTimeout behaves like Execute, but fails with ErrTimeout if
+	// actions does not finish within d.
+	ExecuteWithTimeout(parent context.Context, d time.Duration, actions []Action) error
+
+	// ExecuteWithDeadline behaves like ExecuteWithTimeout, but fails with
+	// ErrTimeout if actions does not finish by deadline.
+	ExecuteWithDeadline(parent context.Context, deadline time.Time, actions []Action) error
+}
+
+// PoolConfig configures a pool created by Pool.
+type PoolConfig struct {
+	// Workers is the number of worker goroutines. If less than or equal to
+	// zero, runtime.NumCPU is used.
+	Workers int
+
+	// QueueSize bounds how many Actions can be enqueued ahead of the
+	// workers. If less than or equal to zero, it defaults to ten times the
+	// number of worker goroutines started.
+	QueueSize int
+}
+
+// Stats is a point-in-time snapshot of a pool's observability counters, as
+// returned by Executor.Stats.
+type Stats struct {
+	// Workers is the number of worker goroutines currently serving the pool.
+	Workers int
+
+	// Queued is the number of Actions enqueued and waiting for a worker.
+	Queued int
+
+	// InFlight is the number of Actions currently running.
+	InFlight int
+
+	// Completed is the total number of Actions that have returned a nil
+	// error.
+	Completed uint64
+
+	// Failed is the total number of Actions that have returned a non-nil
+	// error.
+	Failed uint64
+}
+
+// Future is a handle onto an Action submitted via Executor.Submit.
+type Future interface {
+	// Wait blocks until the Action has run and returns its error.
+	Wait() error
+
+	// Done yields the Action's error once the worker has run it.
+	Done() <-chan error
+
+	// Cancel cancels the context passed to the Action, independently of any
+	// other Action submitted to the same Executor.
+	Cancel()
+}